@@ -88,14 +88,32 @@ type Manager struct {
 	Level    string `json:"level"`
 }
 
-// Customer embeds multiple types
+// Customer embeds multiple types; BaseModel and Person expose disjoint field
+// sets so this flattens cleanly with no collision.
 type Customer struct {
 	BaseModel             // Anonymous embedding
-	Person                // Anonymous embedding - overlapping would fail in real Go
+	Person                // Anonymous embedding - no overlapping field names
 	CustomerNumber string `json:"customer_number"`
 	Tier           string `json:"tier"`
 }
 
+// Contact duplicates Person's "name"/"email" json tags so embedding both
+// below is a genuine collision, not just a same-named Go field.
+type Contact struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+// ConflictingEmbed embeds two types that both expose "name" and "email" -
+// this is the case a collision-resolution policy (error, first-wins,
+// last-wins, prefix-with-type-name, require-explicit-override) must resolve.
+type ConflictingEmbed struct {
+	Person         // Anonymous embedding
+	Contact        // Anonymous embedding - collides with Person on name/email
+	Notes   string `json:"notes,omitempty"`
+}
+
 // Article with soft delete capability
 type Article struct {
 	BaseModel         // ID, created_at, updated_at
@@ -169,10 +187,14 @@ type UserResponseWrapper struct {
 	User           *Person `json:"user"`
 }
 
-// GenericEntity demonstrates a pattern with generic-like composition
+// GenericEntity demonstrates a pattern with generic-like composition; see
+// SetupGenericEntityRoutes for why it carries time.Time, map[string]string,
+// and interface{} fields together.
 type GenericEntity struct {
-	Type    string      `json:"type"`
-	Payload interface{} `json:"payload"`
+	Type     string            `json:"type"`
+	Recorded time.Time         `json:"recorded"`
+	Tags     map[string]string `json:"tags"`
+	Payload  interface{}       `json:"payload"`
 }
 
 // MultiLevelEmbed tests deep embedding
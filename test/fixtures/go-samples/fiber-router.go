@@ -0,0 +1,98 @@
+// Package models contains Fiber v2 router patterns
+// This file tests Fiber framework detection with its own path parameter
+// syntax and variable-based route groups.
+package models
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// FiberUser for response examples
+type FiberUser struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// SetupFiberRouter demonstrates basic Fiber router setup
+func SetupFiberRouter() *fiber.App {
+	app := fiber.New()
+
+	app.Get("/", fiberHomeHandler)
+	app.Get("/health", fiberHealthCheck)
+
+	app.Get("/users", fiberListUsers)
+	app.Post("/users", fiberCreateUser)
+	app.Get("/users/:id", fiberGetUser) // Fiber uses :param syntax
+	app.Put("/users/:id", fiberUpdateUser)
+	app.Delete("/users/:id", fiberDeleteUser)
+
+	// Optional parameter
+	app.Get("/users/:id/:format?", fiberGetUserFormatted)
+
+	// One-or-more and wildcard segments
+	app.Get("/files/+", fiberServeFiles)
+	app.Get("/assets/*", fiberServeAssets)
+
+	return app
+}
+
+// SetupFiberRouteGroups demonstrates Fiber's variable-based groups, where
+// the group prefix must be tracked through the assignment rather than a
+// closure like Chi's r.Route uses.
+func SetupFiberRouteGroups() *fiber.App {
+	app := fiber.New()
+
+	api := app.Group("/api")
+	api.Use(fiberRequestIDMiddleware())
+
+	v1 := api.Group("/v1")
+	v1.Get("/users", fiberListUsersV1)
+	v1.Post("/users", fiberCreateUserV1)
+	v1.Get("/users/:id", fiberGetUserV1)
+
+	v2 := api.Group("/v2")
+	v2.Use(fiberAuthMiddleware())
+	v2.Get("/users", fiberListUsersV2)
+	v2.Get("/users/:id/:postId?", fiberGetUserPostV2)
+
+	return app
+}
+
+func fiberRequestIDMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error { return c.Next() }
+}
+
+func fiberAuthMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error { return c.Next() }
+}
+
+// Handler implementations
+func fiberHomeHandler(c *fiber.Ctx) error { return c.SendString("Home") }
+func fiberHealthCheck(c *fiber.Ctx) error { return c.SendString("OK") }
+func fiberListUsers(c *fiber.Ctx) error   { return c.JSON([]FiberUser{}) }
+func fiberCreateUser(c *fiber.Ctx) error  { return c.SendStatus(fiber.StatusCreated) }
+func fiberGetUser(c *fiber.Ctx) error {
+	id := c.Params("id")
+	return c.JSON(FiberUser{Name: id})
+}
+func fiberUpdateUser(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusOK) }
+func fiberDeleteUser(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusNoContent) }
+func fiberGetUserFormatted(c *fiber.Ctx) error {
+	id := c.Params("id")
+	format := c.Params("format", "json")
+	return c.SendString(id + "." + format)
+}
+func fiberServeFiles(c *fiber.Ctx) error  { return c.SendString("files") }
+func fiberServeAssets(c *fiber.Ctx) error { return c.SendString("assets") }
+func fiberListUsersV1(c *fiber.Ctx) error { return c.JSON([]FiberUser{}) }
+func fiberCreateUserV1(c *fiber.Ctx) error {
+	return c.SendStatus(fiber.StatusCreated)
+}
+func fiberGetUserV1(c *fiber.Ctx) error   { return c.JSON(FiberUser{}) }
+func fiberListUsersV2(c *fiber.Ctx) error { return c.JSON([]FiberUser{}) }
+func fiberGetUserPostV2(c *fiber.Ctx) error {
+	userID := c.Params("id")
+	postID := c.Params("postId", "")
+	return c.SendString(userID + "/" + postID)
+}
@@ -0,0 +1,72 @@
+// Package models contains Gin patterns that wrap *gin.Context
+// This file tests resolving routes registered through a custom Context
+// adapter instead of the raw gin.HandlerFunc signature.
+package models
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Context wraps *gin.Context with project-specific helpers.
+type Context struct {
+	*gin.Context
+	C map[string]interface{}
+}
+
+// Success writes a 200 response with a standard envelope.
+func (c *Context) Success(data interface{}) {
+	c.JSON(http.StatusOK, gin.H{"code": 0, "data": data})
+}
+
+// NotFound writes a 404 response with a standard envelope.
+func (c *Context) NotFound(msg string) {
+	c.JSON(http.StatusNotFound, gin.H{"code": 404, "message": msg})
+}
+
+// DataFormat writes a response with an explicit status code.
+func (c *Context) DataFormat(status int, data interface{}) {
+	c.JSON(status, gin.H{"code": status, "data": data})
+}
+
+// CustomMiddle is the handler signature real routes are written against.
+type CustomMiddle func(c *Context)
+
+// PatchContext adapts a CustomMiddle into a gin.HandlerFunc so it can be
+// registered directly with the router.
+func PatchContext(handler CustomMiddle) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		handler(&Context{Context: ginCtx, C: make(map[string]interface{})})
+	}
+}
+
+// SetupWrappedContextRouter demonstrates routes registered through the
+// PatchContext adapter, so real handler signatures never mention *gin.Context.
+func SetupWrappedContextRouter() *gin.Engine {
+	router := gin.Default()
+
+	router.GET("/users/:id", PatchContext(wrappedGetUser))
+	router.POST("/users", PatchContext(wrappedCreateUser))
+	router.GET("/users/:id/missing", PatchContext(wrappedMissingUser))
+
+	return router
+}
+
+func wrappedGetUser(c *Context) {
+	id := c.Param("id")
+	c.Success(GinUser{Name: id})
+}
+
+func wrappedCreateUser(c *Context) {
+	var user GinUser
+	if err := c.ShouldBindJSON(&user); err != nil {
+		c.DataFormat(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.DataFormat(http.StatusCreated, user)
+}
+
+func wrappedMissingUser(c *Context) {
+	c.NotFound("user not found")
+}
@@ -5,6 +5,10 @@ package models
 import (
 	"net/http"
 
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-contrib/sessions/cookie"
+	"github.com/gin-contrib/sessions/redis"
+	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 )
 
@@ -162,9 +166,39 @@ func SetupGinStaticFiles() *gin.Engine {
 	router.StaticFS("/assets", http.Dir("./assets"))
 	router.StaticFile("/favicon.ico", "./static/favicon.ico")
 
+	// Third-party static mount, distinct from the builtin ones above.
+	router.Use(static.Serve("/app", static.LocalFile("./dist", true)))
+
+	return router
+}
+
+// SetupGinTemplates demonstrates template registration and per-handler
+// template usage so the template dependency graph can be resolved.
+func SetupGinTemplates() *gin.Engine {
+	router := gin.Default()
+
+	router.LoadHTMLGlob("templates/*")
+
+	router.GET("/", ginRenderIndex)
+	router.GET("/users/:id", ginRenderUser)
+	router.GET("/error", ginRenderError)
+
 	return router
 }
 
+func ginRenderIndex(c *gin.Context) {
+	c.HTML(http.StatusOK, "index.html", gin.H{"title": "Home"})
+}
+
+func ginRenderUser(c *gin.Context) {
+	id := c.Param("id")
+	c.HTML(http.StatusOK, "users/show.html", gin.H{"id": id})
+}
+
+func ginRenderError(c *gin.Context) {
+	c.HTML(http.StatusInternalServerError, "error.html", gin.H{"message": "boom"})
+}
+
 // SetupGinWithBinding demonstrates request binding
 func SetupGinWithBinding() *gin.Engine {
 	router := gin.Default()
@@ -190,6 +224,193 @@ func SetupGinWithBinding() *gin.Engine {
 	return router
 }
 
+// SetupGinMiddlewareChains demonstrates group-inherited and third-party
+// middleware so the resolved chain for a route can include more than the
+// handlers registered directly on it.
+func SetupGinMiddlewareChains() *gin.Engine {
+	router := gin.New()
+
+	// Engine-level middleware applies to every route below.
+	router.Use(gin.Logger())
+	router.Use(gin.Recovery())
+
+	// Third-party middleware mounted as a static file server.
+	router.Use(static.Serve("/", static.LocalFile("./app", true)))
+
+	// Positional middleware passed directly to Group().
+	mailer := router.Group("mailer", gin.BasicAuth(gin.Accounts{"ops": "secret"}))
+	{
+		mailer.GET("/queue", ginMailerQueue)
+		mailer.POST("/send", ginMailerSend)
+	}
+
+	// Group-level Use() that nested groups inherit.
+	admin := router.Group("/admin")
+	admin.Use(ginAdminAuth())
+	{
+		admin.GET("/dashboard", ginAdminDashboard)
+
+		// Nested group: inherits router + admin middleware, adds its own.
+		reports := admin.Group("/reports")
+		reports.Use(ginAuditMiddleware())
+		{
+			reports.GET("/daily", ginDailyReport)
+			reports.GET("/monthly", ginMonthlyReport)
+		}
+	}
+
+	return router
+}
+
+func ginMailerQueue(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"queued": 0}) }
+func ginMailerSend(c *gin.Context)  { c.Status(http.StatusAccepted) }
+func ginAuditMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) { c.Next() }
+}
+func ginDailyReport(c *gin.Context)   { c.JSON(http.StatusOK, gin.H{"period": "daily"}) }
+func ginMonthlyReport(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"period": "monthly"}) }
+
+// SetupGinAuthBoundaries demonstrates the auth/session recognizers a security
+// posture summary is built from: basic auth, cookie- and redis-backed
+// sessions, and a custom middleware matched by name rather than package.
+func SetupGinAuthBoundaries() *gin.Engine {
+	router := gin.Default()
+
+	// Public routes - no auth middleware anywhere in the chain.
+	router.GET("/status", ginAPIStatus)
+
+	// Basic auth with an accounts literal passed through a variable.
+	basicAccounts := gin.Accounts{"admin": "changeme"}
+	secure := router.Group("/secure", gin.BasicAuth(basicAccounts))
+	{
+		secure.GET("/ping", ginAPIStatus)
+	}
+
+	// Cookie-backed session store.
+	cookieStore := cookie.NewStore([]byte("cookie-secret"))
+	withCookies := router.Group("/account")
+	withCookies.Use(sessions.Sessions("mysession", cookieStore))
+	{
+		withCookies.GET("/profile", ginAdminDashboard)
+	}
+
+	// Redis-backed session store, keyed by address.
+	redisStore, _ := redis.NewStore(10, "tcp", "localhost:6379", "", []byte("redis-secret"))
+	withRedis := router.Group("/cart")
+	withRedis.Use(sessions.Sessions("cartsession", redisStore))
+	{
+		withRedis.GET("/items", ginListItems)
+	}
+
+	// Custom middleware recognized by name (matches /(?i)auth|jwt|token|login/).
+	jwtProtected := router.Group("/api")
+	jwtProtected.Use(ginJWTAuthMiddleware())
+	{
+		jwtProtected.GET("/me", ginAPIStatus)
+	}
+
+	return router
+}
+
+func ginJWTAuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) { c.Next() }
+}
+
+// SetupGinDeepVersioning demonstrates group prefixes tracked across more than
+// two levels of nesting. Gin's `:param`/`*wildcard` syntax here should
+// normalize to the same `{param}`/`{param*}` form Chi's `{param}`/`{param:regex}`
+// routes already produce, so both frameworks land on one route schema.
+func SetupGinDeepVersioning() *gin.Engine {
+	router := gin.Default()
+
+	api := router.Group("/api")
+	{
+		v1 := api.Group("/v1")
+		{
+			orgs := v1.Group("/orgs/:orgId")
+			{
+				orgs.GET("/teams/:teamId", ginGetOrgTeamMember)
+				orgs.GET("/teams/:teamId/members/:memberId", ginGetOrgTeamMember)
+				orgs.GET("/files/*filepath", ginServeFile)
+			}
+		}
+	}
+
+	return router
+}
+
+// GinUserQuery is bound from the query string via ShouldBindQuery.
+type GinUserQuery struct {
+	Page     int    `json:"page" form:"page" validate:"gte=0"`
+	PageSize int    `json:"page_size" form:"page_size" validate:"gte=1,lte=100"`
+	Sort     string `json:"sort" form:"sort,omitempty"`
+}
+
+// GinUserParams is bound from the URI path via ShouldBindUri.
+type GinUserParams struct {
+	ID int64 `uri:"id" validate:"required"`
+}
+
+// SetupGinRequestSchemas demonstrates binding sources beyond JSON bodies so a
+// route's request schema can be resolved from query and URI parameters too.
+func SetupGinRequestSchemas() *gin.Engine {
+	router := gin.Default()
+
+	router.POST("/users", ginCreateUserSchema)
+	router.GET("/users", ginListUsersSchema)
+	router.GET("/users/:id", ginGetUserSchema)
+	router.GET("/products", ginFilterProductsSchema)
+
+	return router
+}
+
+func ginCreateUserSchema(c *gin.Context) {
+	var req CreateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, GinUser{Name: req.Name, Email: req.Email})
+}
+
+func ginListUsersSchema(c *gin.Context) {
+	var q GinUserQuery
+	if err := c.ShouldBindQuery(&q); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, []GinUser{})
+}
+
+// GinProductFilter exercises the validator rules beyond required/min/max so
+// query-bound parameters land on the route's parameter list with the right
+// schema constraints (enum, format, pattern).
+type GinProductFilter struct {
+	Status  string `json:"status" form:"status" binding:"required" validate:"oneof=active draft archived"`
+	Contact string `json:"contact" form:"contact" validate:"email"`
+	Website string `json:"website,omitempty" form:"website" validate:"url"`
+	TraceID string `json:"trace_id" form:"trace_id" validate:"uuid"`
+	Code    string `json:"code" form:"code" validate:"regexp=^[A-Z]{3}-[0-9]{4}$"`
+}
+
+func ginFilterProductsSchema(c *gin.Context) {
+	var filter GinProductFilter
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, []GinProduct{})
+}
+
+func ginGetUserSchema(c *gin.Context) {
+	var p GinUserParams
+	if err := c.ShouldBindUri(&p); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, GinUser{ID: p.ID})
+}
+
 // Handler implementations
 func ginHomeHandler(c *gin.Context) { c.String(http.StatusOK, "Home") }
 func ginHealthCheck(c *gin.Context) { c.String(http.StatusOK, "OK") }
@@ -6,6 +6,13 @@ package models
 // Note: This file contains INTENTIONALLY invalid syntax
 // for testing parser error handling. The compiler errors
 // are expected and the file should NOT compile.
+//
+// A resilient parse pass should still recover partial results here rather
+// than aborting on the first error, tagging each case with a dedicated
+// diagnostic code: unclosed-tag-quote, duplicate-field-name,
+// non-nameable-embed, recursive-alias, unbalanced-braces. PartiallyValid
+// below is the key case: AnalyzeFileResilient should still emit a
+// NormalizedSchema entry for its ValidField, marked Partial: true.
 // ========================================
 
 // InvalidStruct has syntax errors
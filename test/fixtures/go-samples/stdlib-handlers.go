@@ -191,6 +191,50 @@ func SetupLoopRoutes() {
 	}
 }
 
+// reportPaths is a package-level slice the loop below only references by
+// name, so resolving its routes requires folding this var initializer too.
+var reportPaths = []string{"/reports/daily", "/reports/weekly"}
+
+// extraReportPaths is built via append chains rather than a literal.
+var extraReportPaths = append(append([]string{}, "/reports/monthly"), "/reports/yearly")
+
+// SetupConstFoldedRoutes registers routes from a package-level var and an
+// append-chain var, exercising constant folding beyond an inline literal.
+func SetupConstFoldedRoutes() {
+	for _, p := range reportPaths {
+		path := p
+		http.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "Report: %s", path)
+		})
+	}
+	for _, p := range extraReportPaths {
+		path := p
+		http.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "Report: %s", path)
+		})
+	}
+}
+
+// SetupDynamicRoutes builds its path list at runtime from an external call,
+// so it cannot be constant-folded from the loop below alone. The
+// //trace:routes annotation supplies the resolved paths explicitly, so this
+// fixture should resolve to "/dynamic/a" and "/dynamic/b" rather than falling
+// back to a single "dynamic-routes" diagnostic.
+//
+// //trace:routes ["/dynamic/a", "/dynamic/b"]
+func SetupDynamicRoutes() {
+	for _, p := range loadDynamicPaths() {
+		path := p
+		http.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "Dynamic: %s", path)
+		})
+	}
+}
+
+func loadDynamicPaths() []string {
+	return []string{"/dynamic/a", "/dynamic/b"}
+}
+
 // FileServer example
 func SetupFileServer() {
 	// Static file serving
@@ -201,3 +245,67 @@ func SetupFileServer() {
 		http.ServeFile(w, r, "./static/favicon.ico")
 	})
 }
+
+// SetupAuditRoutes registers a handler whose payload type comes from the
+// embedded-struct model registry: AuditLog embeds BaseModel but redeclares
+// ID as a string UUID, so the emitted schema must honor the override rather
+// than flattening BaseModel.ID verbatim.
+func SetupAuditRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/audit-logs", handleAuditLogs)
+}
+
+// SetupDeepEmbedRoutes registers a handler whose wire type is Level4, which
+// embeds Level3 -> Level2 -> Level1 four levels deep. A client/server stub
+// generator must flatten this into one schema on the wire rather than
+// nesting each level.
+func SetupDeepEmbedRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/deep", handleDeepEmbed)
+}
+
+// SetupGenericEntityRoutes registers a handler whose payload mixes a
+// time.Time field, a map[string]string field, and an interface{} payload -
+// the three cases a protobuf emitter must map to Timestamp, map<string,
+// string>, and google.protobuf.Any respectively.
+func SetupGenericEntityRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/api/entities", handleGenericEntity)
+}
+
+func handleGenericEntity(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(NestedResponse{Data: GenericEntity{}, Success: true})
+	case http.MethodPost:
+		var entity GenericEntity
+		json.NewDecoder(r.Body).Decode(&entity)
+		json.NewEncoder(w).Encode(NestedResponse{Data: entity, Success: true})
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func handleDeepEmbed(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode(Level4{})
+	case http.MethodPost:
+		var payload Level4
+		json.NewDecoder(r.Body).Decode(&payload)
+		json.NewEncoder(w).Encode(payload)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func handleAuditLogs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		json.NewEncoder(w).Encode([]AuditLog{})
+	case http.MethodPost:
+		var entry AuditLog
+		json.NewDecoder(r.Body).Decode(&entry)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(entry)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
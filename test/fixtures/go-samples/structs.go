@@ -91,7 +91,17 @@ type Metadata struct {
 
 // MultiFieldRow demonstrates multiple fields on single line (less common but valid)
 type MultiFieldRow struct {
-	X, Y, Z int `json:"x"` // Only first field gets the tag in standard practice
+	X, Y, Z int `json:"x"` // Go applies this tag to X, Y, and Z alike - not just X
+}
+
+// MultiSerializationRecord carries json/db/xml/form/validate tags on the same
+// fields so a single extraction pass can drive multiple downstream formats.
+type MultiSerializationRecord struct {
+	ID     int64  `json:"id" db:"id,pk" xml:"id,attr" validate:"required"`
+	Name   string `json:"name" db:"name" xml:"Name" form:"name" validate:"required,min=1"`
+	Note   string `json:"note,omitempty" db:"note,omitempty" xml:"note,omitempty"`
+	Legacy string `json:"-" db:"legacy_col" xml:"-"`
+	RawTag string `json:"-,"` // name is the literal string "-"
 }
 
 // EmptyStruct represents an empty struct (marker type)
@@ -0,0 +1,69 @@
+// Package models contains swaggo-style doc-comment annotations
+// This file tests merging @Summary/@Param/@Success/@Router metadata from
+// handler doc comments into the route topology discovered via AST calls.
+package models
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SwaggerUser is the model referenced by the annotated handlers below.
+type SwaggerUser struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// SetupSwaggerAnnotatedRouter registers the handlers whose doc comments carry
+// swaggo annotations.
+func SetupSwaggerAnnotatedRouter() *gin.Engine {
+	router := gin.Default()
+
+	router.GET("/users", listUsersSwagger)
+	router.GET("/users/:id", getUserSwagger)
+	router.POST("/users", createUserSwagger)
+
+	return router
+}
+
+// listUsersSwagger returns all users.
+//
+// @Summary List users
+// @Tags users
+// @Success 200 {array} SwaggerUser
+// @Router /users [get]
+func listUsersSwagger(c *gin.Context) {
+	c.JSON(http.StatusOK, []SwaggerUser{})
+}
+
+// getUserSwagger returns a single user by ID.
+//
+// @Summary Get a user
+// @Tags users
+// @Param id path int true "User ID"
+// @Success 200 {object} SwaggerUser
+// @Failure 404 {object} gin.H
+// @Router /users/{id} [get]
+func getUserSwagger(c *gin.Context) {
+	id := c.Param("id")
+	c.JSON(http.StatusOK, SwaggerUser{Name: id})
+}
+
+// createUserSwagger creates a new user.
+//
+// @Summary Create a user
+// @Tags users
+// @Param user body SwaggerUser true "User payload"
+// @Success 201 {object} SwaggerUser
+// @Security ApiKeyAuth
+// @Router /users [post]
+func createUserSwagger(c *gin.Context) {
+	var user SwaggerUser
+	if err := c.ShouldBindJSON(&user); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusCreated, user)
+}
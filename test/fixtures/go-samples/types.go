@@ -303,3 +303,13 @@ type CircularType struct {
 	Parent   *CircularType  `json:"parent,omitempty"`
 	Children []CircularType `json:"children,omitempty"`
 }
+
+// ContainerUsage instantiates the generic Container directly as field types
+// (rather than only through a type alias) so each instantiation gets its own
+// distinct schema entry, e.g. Container[string] -> Container_string.
+type ContainerUsage struct {
+	Label   Container[string]     `json:"label"`
+	Count   Container[int]        `json:"count"`
+	Profile Container[SimpleUser] `json:"profile"`
+	Pair    Pair[string, int]     `json:"pair"`
+}